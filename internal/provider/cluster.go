@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -9,23 +10,61 @@ import (
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
 	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla/model"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// CloudProvider abstracts over the region/instance lookups needed to
+// create or resize a cluster, so that new cloud providers slot in
+// without further schema changes.
+type CloudProvider interface {
+	RegionByName(name string) *model.Region
+	InstanceByName(name string) *model.Instance
+	InstanceByID(id int64) *model.Instance
+}
+
+// cloudProviderMeta resolves the "cloud_provider" attribute value to its
+// metadata and its numeric cloud provider id.
+func cloudProviderMeta(c *scylla.Client, name string) (CloudProvider, int64, error) {
+	switch strings.ToUpper(name) {
+	case "AWS":
+		return c.Meta.AWS, c.Meta.AWS.CloudProvider.ID, nil
+	case "GCP":
+		return c.Meta.GCP, c.Meta.GCP.CloudProvider.ID, nil
+	default:
+		return nil, 0, fmt.Errorf(`unrecognized value %q for "cloud_provider" attribute`, name)
+	}
+}
+
+// cloudProviderName is the inverse of cloudProviderMeta: it maps the
+// numeric cloud provider id reported by the API back to the "cloud_provider"
+// attribute value. It falls back to "AWS" for an unrecognized id, including
+// zero, which covers state read into an import where the id hasn't been
+// set yet and a c.Meta.GCP.CloudProvider.ID of 0 when GCP metadata hasn't
+// loaded.
+func cloudProviderName(c *scylla.Client, id int64) string {
+	if gid := c.Meta.GCP.CloudProvider.ID; gid != 0 && id == gid {
+		return "GCP"
+	}
+
+	return "AWS"
+}
+
 const (
 	clusterRetryTimeout    = 40 * time.Minute
 	clusterDeleteTimeout   = 90 * time.Minute
 	clusterRetryDelay      = 5 * time.Second
 	clusterRetryMinTimeout = 15 * time.Second
-	clusterPollInterval    = 10 * time.Second
 )
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		CreateContext: resourceClusterCreate,
+		ReadContext:   resourceClusterRead,
+		UpdateContext: resourceClusterUpdate,
+		DeleteContext: resourceClusterDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -33,6 +72,7 @@ func ResourceCluster() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(clusterRetryTimeout),
+			Read:   schema.DefaultTimeout(clusterRetryTimeout),
 			Update: schema.DefaultTimeout(clusterRetryTimeout),
 			Delete: schema.DefaultTimeout(clusterDeleteTimeout),
 		},
@@ -49,18 +89,21 @@ func ResourceCluster() *schema.Resource {
 				ForceNew:    true,
 				Type:        schema.TypeString,
 			},
-			"region": {
-				Description: "Region to use",
-				Required:    true,
-				ForceNew:    true,
-				Type:        schema.TypeString,
-			},
-			"node_count": {
-				Description: "Node count",
-				Required:    true,
-				ForceNew:    true,
-				Type:        schema.TypeInt,
+			"cloud_provider": {
+				Description:  "Cloud provider to deploy the cluster into, either AWS or GCP",
+				Optional:     true,
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				Default:      "AWS",
+				ValidateFunc: validation.StringInSlice([]string{"AWS", "GCP"}, false),
 			},
+			// NOTE: provider-specific hints (e.g. a GCP project/network to
+			// peer the cluster's VPC into) are intentionally out of scope
+			// here; region/instance lookup is all that's needed to create
+			// a cluster today, and VPC peering configuration is currently
+			// out-of-band via the Scylla Cloud console regardless of
+			// cloud_provider. Add an optional nested "gcp"/"aws" block
+			// if and when that peering flow is exposed through this API.
 			"user_api_interface": {
 				Description: "Type of API interface, either CQL or ALTERNATOR",
 				Optional:    true,
@@ -75,19 +118,6 @@ func ResourceCluster() *schema.Resource {
 				Type:        schema.TypeString,
 				Default:     "only_rmw_uses_lwt",
 			},
-			"node_type": {
-				Description: "Instance type of a node",
-				Required:    true,
-				ForceNew:    true,
-				Type:        schema.TypeString,
-			},
-			"cidr_block": {
-				Description: "IPv4 CIDR of the cluster",
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
-				Type:        schema.TypeString,
-			},
 			"scylla_version": {
 				Description: "Scylla version",
 				Optional:    true,
@@ -119,10 +149,63 @@ func ResourceCluster() *schema.Resource {
 				Computed:    true,
 				Type:        schema.TypeInt,
 			},
+			"skip_destroy": {
+				Description: "Whether to retain the cluster on `terraform destroy` instead of deleting it",
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Default:     false,
+			},
 			"datacenter": {
-				Description: "Cluster datacenter name",
-				Computed:    true,
-				Type:        schema.TypeString,
+				Description: "Cluster datacenters; one of them is created together with the cluster, the rest can be added, removed or resized in place",
+				Required:    true,
+				MinItems:    1,
+				Type:        schema.TypeSet,
+				Set:         datacenterHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Datacenter name",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+						},
+						"region": {
+							Description: "Region to use",
+							Required:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+						},
+						"node_count": {
+							Description: "Node count; changing it scales the datacenter one node at a time",
+							Required:    true,
+							Type:        schema.TypeInt,
+						},
+						"node_type": {
+							Description: "Instance type of a node",
+							Required:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+						},
+						"cidr_block": {
+							Description: "IPv4 CIDR of the datacenter",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+						},
+						"datacenter_id": {
+							Description: "Datacenter id",
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						"status": {
+							Description: "Datacenter status",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
 			},
 			"status": {
 				Description: "Cluster status",
@@ -133,23 +216,35 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
-func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
+// datacenterHash keys a "datacenter" set member on its region, the one
+// attribute that is both required and never reassigned to a different
+// datacenter, so that reordering the block in configuration or a
+// differently-ordered API response never reads as a diff.
+func datacenterHash(v interface{}) int {
+	dc := v.(map[string]interface{})
+
+	return schema.HashString(dc["region"].(string))
+}
+
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
-		c = meta.(*scylla.Client)
-		r = &model.ClusterCreateRequest{
+		c   = meta.(*scylla.Client)
+		dcs = d.Get("datacenter").(*schema.Set).List()
+		dc0 = dcs[0].(map[string]interface{})
+		r   = &model.ClusterCreateRequest{
 			AccountCredentialID:  1,
 			ClusterName:          d.Get("name").(string),
 			BroadcastType:        "PRIVATE",
 			ReplicationFactor:    3,
-			NumberOfNodes:        int64(d.Get("node_count").(int)),
+			NumberOfNodes:        int64(dc0["node_count"].(int)),
 			UserAPIInterface:     d.Get("user_api_interface").(string),
 			EnableDNSAssociation: d.Get("enable_dns").(bool),
 		}
-		cidr, cidrOK       = d.GetOk("cidr_block")
-		region             = d.Get("region").(string)
-		nodeType           = d.Get("node_type").(string)
-		version, versionOK = d.GetOk("scylla_version")
-		enableVpcPeering   = d.Get("enable_vpc_peering").(bool)
+		cidr             = dc0["cidr_block"].(string)
+		region           = dc0["region"].(string)
+		nodeType         = dc0["node_type"].(string)
+		version, hasVer  = d.GetOk("scylla_version")
+		enableVpcPeering = d.Get("enable_vpc_peering").(bool)
 	)
 
 	if !enableVpcPeering {
@@ -160,160 +255,424 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		r.AlternatorWriteIsolation = d.Get("alternator_write_isolation").(string)
 	}
 
-	if !cidrOK {
+	if cidr == "" {
 		cidr = "172.31.0.0/16"
-		d.Set("cidr_block", cidr)
 	}
 
-	r.CidrBlock = cidr.(string)
+	r.CidrBlock = cidr
 
-	r.CloudProviderID = c.Meta.AWS.CloudProvider.ID
+	provider, providerID, err := cloudProviderMeta(c, d.Get("cloud_provider").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	if mr := c.Meta.AWS.RegionByName(region); mr != nil {
+	r.CloudProviderID = providerID
+
+	if mr := provider.RegionByName(region); mr != nil {
 		r.RegionID = mr.ID
 	} else {
-		return fmt.Errorf(`unrecognized value %q for "region" attribute`, region)
+		return diag.Errorf(`unrecognized value %q for "region" attribute`, region)
 	}
 
-	if mi := c.Meta.AWS.InstanceByName(nodeType); mi != nil {
+	if mi := provider.InstanceByName(nodeType); mi != nil {
 		r.InstanceID = mi.ID
 	} else {
-		return fmt.Errorf(`unrecognized value %q for "node_type" attribute`, nodeType)
+		return diag.Errorf(`unrecognized value %q for "node_type" attribute`, nodeType)
 	}
 
-	if defaultID := c.Meta.ScyllaVersions.DefaultScyllaVersionID; !versionOK {
+	if defaultID := c.Meta.ScyllaVersions.DefaultScyllaVersionID; !hasVer {
 		r.ScyllaVersionID = c.Meta.ScyllaVersions.DefaultScyllaVersionID
 		d.Set("scylla_version", c.Meta.VersionByID(defaultID).Version)
 	} else if mv := c.Meta.VersionByName(version.(string)); mv != nil {
 		r.ScyllaVersionID = mv.VersionID
 	} else {
-		return fmt.Errorf(`unrecognized value %q for "scylla_version" attribute`, version)
+		return diag.Errorf(`unrecognized value %q for "scylla_version" attribute`, version)
 	}
 
 	cr, err := c.CreateCluster(r)
 	if err != nil {
-		return fmt.Errorf("error creating cluster: %w", err)
+		return diag.Errorf("error creating cluster: %s", err)
 	}
 
 	d.SetId(strconv.Itoa(int(cr.ClusterID)))
 	d.Set("cluster_id", cr.ClusterID)
 	d.Set("request_id", cr.ID)
 
-	if err := waitForCluster(c, cr.ID); err != nil {
-		return fmt.Errorf("error waiting for cluster: %w", err)
+	if err := waitForCluster(ctx, c, cr.ID); err != nil {
+		return diag.Errorf("error waiting for cluster: %s", err)
+	}
+
+	providerName := d.Get("cloud_provider").(string)
+
+	for _, v := range dcs[1:] {
+		if err := addDatacenter(ctx, c, cr.ClusterID, providerName, v.(map[string]interface{})); err != nil {
+			return diag.Errorf("error adding datacenter: %s", err)
+		}
 	}
 
 	cluster, err := c.GetCluster(cr.ClusterID)
 	if err != nil {
-		return fmt.Errorf("error reading cluster: %w", err)
+		return diag.Errorf("error reading cluster: %s", err)
 	}
 
-	d.Set("datacenter_id", cluster.Datacenter.ID)
+	flattened, err := flattenDatacenters(c, providerName, cluster.Datacenters)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("datacenter", flattened)
 
 	return nil
 }
 
-func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
+// addDatacenter issues an add-datacenter cluster request for clusterID and
+// blocks until it is COMPLETED.
+func addDatacenter(ctx context.Context, c *scylla.Client, clusterID int64, providerName string, dc map[string]interface{}) error {
+	var (
+		region   = dc["region"].(string)
+		nodeType = dc["node_type"].(string)
+		r        = &model.ClusterAddDatacenterRequest{
+			ClusterID:      clusterID,
+			DatacenterName: dc["name"].(string),
+			NumberOfNodes:  int64(dc["node_count"].(int)),
+			CidrBlock:      dc["cidr_block"].(string),
+		}
+	)
+
+	provider, _, err := cloudProviderMeta(c, providerName)
+	if err != nil {
+		return err
+	}
+
+	if mr := provider.RegionByName(region); mr != nil {
+		r.RegionID = mr.ID
+	} else {
+		return fmt.Errorf(`unrecognized value %q for "region" attribute`, region)
+	}
+
+	if mi := provider.InstanceByName(nodeType); mi != nil {
+		r.InstanceID = mi.ID
+	} else {
+		return fmt.Errorf(`unrecognized value %q for "node_type" attribute`, nodeType)
+	}
+
+	ar, err := c.AddDatacenter(r)
+	if err != nil {
+		return fmt.Errorf("error requesting datacenter: %w", err)
+	}
+
+	return waitForCluster(ctx, c, ar.ID)
+}
+
+// flattenDatacenters converts the API representation of a cluster's
+// datacenters into the nested "datacenter" list expected by the schema.
+func flattenDatacenters(c *scylla.Client, providerName string, dcs []model.Datacenter) ([]interface{}, error) {
+	provider, _, err := cloudProviderMeta(c, providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]interface{}, len(dcs))
+
+	for i, dc := range dcs {
+		// The instance may not resolve under providerName, e.g. a GCP
+		// datacenter read back while "cloud_provider" still defaults to
+		// AWS; report an empty node_type rather than panicking.
+		var nodeType string
+		if instance := provider.InstanceByID(dc.InstanceID); instance != nil {
+			nodeType = instance.ExternalID
+		}
+
+		out[i] = map[string]interface{}{
+			"name":          dc.Name,
+			"region":        dc.Region.ExternalID,
+			"node_count":    len(model.NodesByStatus(dc.Nodes, "ACTIVE")),
+			"node_type":     nodeType,
+			"cidr_block":    dc.CIDRBlock,
+			"datacenter_id": dc.ID,
+			"status":        dc.Status,
+		}
+	}
+
+	return out, nil
+}
+
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		c = meta.(*scylla.Client)
 	)
 
 	clusterID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return fmt.Errorf("error reading id=%q: %w", d.Id(), err)
+		return diag.Errorf("error reading id=%q: %s", d.Id(), err)
 	}
 
 	reqs, err := c.ListClusterRequest(clusterID, "CREATE_CLUSTER")
 	if err != nil {
-		return fmt.Errorf("error reading cluster request: %w", err)
+		return diag.Errorf("error reading cluster request: %s", err)
 	}
 	if len(reqs) != 1 {
-		return fmt.Errorf("unexpected number of cluster requests, expected 1, got: %+v", reqs)
+		return diag.Errorf("unexpected number of cluster requests, expected 1, got: %+v", reqs)
 	}
 
 	if reqs[0].Status != "COMPLETED" {
-		if err := waitForCluster(c, reqs[0].ID); err != nil {
-			return fmt.Errorf("error waiting for cluster: %w", err)
+		if err := waitForCluster(ctx, c, reqs[0].ID); err != nil {
+			return diag.Errorf("error waiting for cluster: %s", err)
 		}
 	}
 
 	cluster, err := c.GetCluster(clusterID)
 	if err != nil {
-		return fmt.Errorf("error reading cluster: %w", err)
+		return diag.Errorf("error reading cluster: %s", err)
 	}
 
-	if n := len(cluster.Datacenters); n > 1 {
-		return fmt.Errorf("multi-datacenter clusters are not currently supported: %d", n)
+	providerName := cloudProviderName(c, cluster.CloudProviderID)
+
+	flattened, err := flattenDatacenters(c, providerName, cluster.Datacenters)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Set("cluster_id", cluster.ID)
 	d.Set("name", cluster.ClusterName)
-	d.Set("region", cluster.Region.ExternalID)
-	d.Set("node_count", len(model.NodesByStatus(cluster.Nodes, "ACTIVE")))
+	d.Set("cloud_provider", providerName)
 	d.Set("user_api_interface", cluster.UserAPIInterface)
-	d.Set("node_type", c.Meta.AWS.InstanceByID(cluster.Datacenter.InstanceID).ExternalID)
-	d.Set("cidr_block", cluster.Datacenter.CIDRBlock)
 	d.Set("scylla_version", cluster.ScyllaVersion.Version)
 	d.Set("enable_vpc_peering", !strings.EqualFold(cluster.BroadcastType, "PUBLIC"))
 	d.Set("enable_dns", cluster.DNS)
 	d.Set("request_id", reqs[0].ID)
-	d.Set("datacenter", cluster.Datacenter.Name)
+	d.Set("datacenter", flattened)
 	d.Set("status", cluster.Status)
 
 	return nil
 }
 
-func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	// Scylla Cloud API does not support updating a cluster,
-	// thus the update always fails
-	return fmt.Errorf(`updating "scylla_cluster" resource is not supported`)
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c = meta.(*scylla.Client)
+	)
+
+	clusterID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error reading id=%q: %s", d.Id(), err)
+	}
+
+	if !d.HasChange("datacenter") {
+		return resourceClusterRead(ctx, d, meta)
+	}
+
+	providerName := d.Get("cloud_provider").(string)
+
+	before, after := d.GetChange("datacenter")
+	oldDCs := datacentersByKey(before.(*schema.Set).List())
+	newDCs := datacentersByKey(after.(*schema.Set).List())
+
+	for key, dc := range newDCs {
+		if _, ok := oldDCs[key]; !ok {
+			if err := addDatacenter(ctx, c, clusterID, providerName, dc); err != nil {
+				return diag.Errorf("error adding datacenter in region %q: %s", dc["region"], err)
+			}
+		}
+	}
+
+	for key, dc := range oldDCs {
+		if _, ok := newDCs[key]; !ok {
+			if err := removeDatacenter(ctx, c, clusterID, dc); err != nil {
+				return diag.Errorf("error removing datacenter in region %q: %s", dc["region"], err)
+			}
+		}
+	}
+
+	maxRF, err := maxReplicationFactor(c, clusterID)
+	if err != nil {
+		return diag.Errorf("error reading keyspaces: %s", err)
+	}
+
+	for key, dc := range newDCs {
+		prev, ok := oldDCs[key]
+		if !ok {
+			continue
+		}
+
+		oldCount := prev["node_count"].(int)
+		newCount := dc["node_count"].(int)
+		datacenterID := int64(prev["datacenter_id"].(int))
+
+		switch {
+		case newCount > oldCount:
+			for i := 0; i < newCount-oldCount; i++ {
+				if err := addNode(ctx, c, clusterID, datacenterID); err != nil {
+					return diag.Errorf("error adding node to datacenter in region %q: %s", dc["region"], err)
+				}
+			}
+		case newCount < oldCount:
+			if err := validateDecommission(dc["region"].(string), newCount, maxRF); err != nil {
+				return diag.FromErr(err)
+			}
+			for i := 0; i < oldCount-newCount; i++ {
+				if err := decommissionNode(ctx, c, clusterID, datacenterID); err != nil {
+					return diag.Errorf("error decommissioning node in datacenter region %q: %s", dc["region"], err)
+				}
+			}
+		}
+	}
+
+	return resourceClusterRead(ctx, d, meta)
+}
+
+// datacentersByKey indexes a "datacenter" list on a stable identifier: the
+// remote datacenter_id for entries the API already knows about, or the
+// (immutable) region for entries newly declared in configuration. "name"
+// is optional+computed and therefore empty for unnamed datacenters, which
+// would otherwise collapse every new, unnamed entry onto the same key.
+func datacentersByKey(dcs []interface{}) map[string]map[string]interface{} {
+	out := make(map[string]map[string]interface{}, len(dcs))
+
+	for _, v := range dcs {
+		dc := v.(map[string]interface{})
+
+		key := dc["region"].(string)
+		if id := dc["datacenter_id"].(int); id != 0 {
+			key = strconv.Itoa(id)
+		}
+
+		out[key] = dc
+	}
+
+	return out
+}
+
+// removeDatacenter issues a delete-datacenter cluster request and blocks
+// until it is COMPLETED.
+func removeDatacenter(ctx context.Context, c *scylla.Client, clusterID int64, dc map[string]interface{}) error {
+	r, err := c.DeleteDatacenter(clusterID, int64(dc["datacenter_id"].(int)))
+	if err != nil {
+		return fmt.Errorf("error requesting datacenter removal: %w", err)
+	}
+
+	return waitForCluster(ctx, c, r.ID)
+}
+
+// addNode issues an add-node cluster request against datacenterID and
+// blocks until it is COMPLETED.
+func addNode(ctx context.Context, c *scylla.Client, clusterID, datacenterID int64) error {
+	r, err := c.AddNode(clusterID, datacenterID)
+	if err != nil {
+		return fmt.Errorf("error requesting node: %w", err)
+	}
+
+	return waitForCluster(ctx, c, r.ID)
+}
+
+// decommissionNode issues a decommission-node cluster request against
+// datacenterID and blocks until it is COMPLETED.
+func decommissionNode(ctx context.Context, c *scylla.Client, clusterID, datacenterID int64) error {
+	r, err := c.DecommissionNode(clusterID, datacenterID)
+	if err != nil {
+		return fmt.Errorf("error requesting node decommission: %w", err)
+	}
+
+	return waitForCluster(ctx, c, r.ID)
 }
 
-func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
+// maxReplicationFactor returns the highest replication factor across all
+// keyspaces on the cluster, used to guard against decommissioning nodes
+// out from under a keyspace's quorum.
+func maxReplicationFactor(c *scylla.Client, clusterID int64) (int, error) {
+	keyspaces, err := c.ListKeyspaces(clusterID)
+	if err != nil {
+		return 0, err
+	}
+
+	var maxRF int
+	for _, ks := range keyspaces {
+		if ks.ReplicationFactor > maxRF {
+			maxRF = ks.ReplicationFactor
+		}
+	}
+
+	return maxRF, nil
+}
+
+// validateDecommission rejects scaling a datacenter down to newCount nodes
+// if that would drop the node count below maxRF, the highest keyspace
+// replication factor known to the client, since decommissioning past that
+// point can no longer satisfy quorum for every keyspace.
+func validateDecommission(region string, newCount, maxRF int) error {
+	if newCount < maxRF {
+		return fmt.Errorf("cannot scale datacenter in region %q down to %d node(s): keyspace replication factor is %d", region, newCount, maxRF)
+	}
+
+	return nil
+}
+
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		c = meta.(*scylla.Client)
 	)
 
+	if d.Get("skip_destroy").(bool) {
+		d.SetId("")
+		return nil
+	}
+
 	clusterID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return fmt.Errorf("error reading id=%q: %w", d.Id(), err)
+		return diag.Errorf("error reading id=%q: %s", d.Id(), err)
 	}
 
 	name, ok := d.GetOk("name")
 	if !ok {
-		return fmt.Errorf("unable to read cluster name from state file")
+		return diag.Errorf("unable to read cluster name from state file")
 	}
 
 	r, err := c.DeleteCluster(clusterID, name.(string))
 	if err != nil {
-		return fmt.Errorf("error deleting cluster: %w", err)
+		return diag.Errorf("error deleting cluster: %s", err)
 	}
 
 	if !strings.EqualFold(r.Status, "QUEUED") && !strings.EqualFold(r.Status, "IN_PROGRESS") {
-		return fmt.Errorf("delete request failure: %q", r.UserFriendlyError)
+		return diag.Errorf("delete request failure: %q", r.UserFriendlyError)
 	}
 
 	return nil
 }
 
-func waitForCluster(c *scylla.Client, requestID int64) error {
-	t := time.NewTicker(clusterPollInterval)
-	defer t.Stop()
-
-	for range t.C {
-		r, err := c.GetClusterRequest(requestID)
-		if err != nil {
-			return fmt.Errorf("error reading cluster request: %w", err)
-		}
-
-		if strings.EqualFold(r.Status, "COMPLETED") {
-			break
-		} else if strings.EqualFold(r.Status, "QUEUED") || strings.EqualFold(r.Status, "IN_PROGRESS") {
-			continue
-		}
+// waitForCluster polls a cluster_request until it reaches COMPLETED,
+// backing off exponentially between polls, and honors ctx cancellation
+// and deadline so a hung request fails the enclosing resource timeout
+// instead of looping forever. Falls back to clusterRetryTimeout when ctx
+// carries no deadline, so the poll stays bounded even if a caller forgets
+// to derive ctx from a resource timeout.
+func waitForCluster(ctx context.Context, c *scylla.Client, requestID int64) error {
+	timeout := clusterRetryTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
 
-		return fmt.Errorf("unrecognized cluster request status: %q", r.Status)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"QUEUED", "IN_PROGRESS"},
+		Target:     []string{"COMPLETED"},
+		Delay:      clusterRetryDelay,
+		MinTimeout: clusterRetryMinTimeout,
+		Timeout:    timeout,
+		Refresh: func() (interface{}, string, error) {
+			r, err := c.GetClusterRequest(requestID)
+			if err != nil {
+				return nil, "", fmt.Errorf("error reading cluster request: %w", err)
+			}
+
+			status := strings.ToUpper(r.Status)
+
+			if status != "QUEUED" && status != "IN_PROGRESS" && status != "COMPLETED" {
+				return nil, "", fmt.Errorf("unrecognized cluster request status: %q", r.Status)
+			}
+
+			return r, status, nil
+		},
 	}
 
-	return nil
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
 }
@@ -0,0 +1,66 @@
+package provider
+
+import "testing"
+
+func TestValidateDecommission(t *testing.T) {
+	tests := []struct {
+		name     string
+		newCount int
+		maxRF    int
+		wantErr  bool
+	}{
+		{name: "below replication factor", newCount: 2, maxRF: 3, wantErr: true},
+		{name: "equal to replication factor", newCount: 3, maxRF: 3, wantErr: false},
+		{name: "above replication factor", newCount: 5, maxRF: 3, wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDecommission("us-east-1", tt.newCount, tt.maxRF)
+			if tt.wantErr && err == nil {
+				t.Fatalf("validateDecommission(%d, %d) = nil, want error", tt.newCount, tt.maxRF)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("validateDecommission(%d, %d) = %v, want nil", tt.newCount, tt.maxRF, err)
+			}
+		})
+	}
+}
+
+func TestDatacentersByKey(t *testing.T) {
+	existing := map[string]interface{}{
+		"name":          "dc1",
+		"region":        "us-east-1",
+		"datacenter_id": 42,
+	}
+	newFirst := map[string]interface{}{
+		"name":          "",
+		"region":        "us-east-1",
+		"datacenter_id": 0,
+	}
+	newSecond := map[string]interface{}{
+		"name":          "",
+		"region":        "eu-west-1",
+		"datacenter_id": 0,
+	}
+
+	t.Run("existing datacenter keys on datacenter_id", func(t *testing.T) {
+		out := datacentersByKey([]interface{}{existing})
+		if _, ok := out["42"]; !ok {
+			t.Fatalf("datacentersByKey(existing) = %v, want key %q", out, "42")
+		}
+	})
+
+	t.Run("new unnamed datacenters key on distinct regions", func(t *testing.T) {
+		out := datacentersByKey([]interface{}{newFirst, newSecond})
+		if len(out) != 2 {
+			t.Fatalf("datacentersByKey(two new unnamed dcs) = %v, want 2 distinct entries", out)
+		}
+		if _, ok := out["us-east-1"]; !ok {
+			t.Fatalf("datacentersByKey missing key %q: %v", "us-east-1", out)
+		}
+		if _, ok := out["eu-west-1"]; !ok {
+			t.Fatalf("datacentersByKey missing key %q: %v", "eu-west-1", out)
+		}
+	})
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scylladb/terraform-provider-scylladbcloud/internal/scylla"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	clusterUpgradeTimeout     = 4 * time.Hour
+	clusterUpgradeNodeTimeout = 40 * time.Minute
+)
+
+// ResourceClusterUpgrade performs a rolling upgrade of an existing
+// scylla_cluster to a target scylla_version. It has no delete-side effect
+// on the cluster: destroying it merely forgets the upgrade from state.
+func ResourceClusterUpgrade() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterUpgradeCreate,
+		ReadContext:   resourceClusterUpgradeRead,
+		UpdateContext: resourceClusterUpgradeUpdate,
+		DeleteContext: resourceClusterUpgradeDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(clusterUpgradeTimeout),
+			Update: schema.DefaultTimeout(clusterUpgradeTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Description: "Id of the cluster to upgrade",
+				Required:    true,
+				ForceNew:    true,
+				Type:        schema.TypeInt,
+			},
+			"scylla_version": {
+				Description: "Target Scylla version; changing it triggers a new rolling upgrade",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"status": {
+				Description: "Status of the last upgrade attempt",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"snapshot_tag": {
+				Description: "Tag of the snapshots taken by the last completed upgrade; the snapshots themselves are deleted once that upgrade completes",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceClusterUpgradeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c         = meta.(*scylla.Client)
+		clusterID = int64(d.Get("cluster_id").(int))
+		version   = d.Get("scylla_version").(string)
+	)
+
+	tag, err := upgradeCluster(ctx, d, c, clusterID, version)
+	if err != nil {
+		d.Set("status", "FAILED")
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(clusterID, 10))
+	d.Set("status", "COMPLETED")
+	d.Set("snapshot_tag", tag)
+
+	return nil
+}
+
+func resourceClusterUpgradeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c = meta.(*scylla.Client)
+	)
+
+	clusterID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error reading id=%q: %s", d.Id(), err)
+	}
+
+	cluster, err := c.GetCluster(clusterID)
+	if err != nil {
+		return diag.Errorf("error reading cluster: %s", err)
+	}
+
+	d.Set("cluster_id", cluster.ID)
+
+	// "scylla_version" accepts a name/alias as well as a dotted version
+	// string; only overwrite it with the cluster's resolved version when
+	// the configured value no longer resolves to what's actually running.
+	// Always overwriting here would show a perpetual diff against an
+	// alias that already matches the running version.
+	if mv := c.Meta.VersionByName(d.Get("scylla_version").(string)); mv == nil || mv.Version != cluster.ScyllaVersion.Version {
+		d.Set("scylla_version", cluster.ScyllaVersion.Version)
+	}
+
+	return nil
+}
+
+func resourceClusterUpgradeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		c         = meta.(*scylla.Client)
+		clusterID = int64(d.Get("cluster_id").(int))
+	)
+
+	if !d.HasChange("scylla_version") {
+		return resourceClusterUpgradeRead(ctx, d, meta)
+	}
+
+	version := d.Get("scylla_version").(string)
+
+	tag, err := upgradeCluster(ctx, d, c, clusterID, version)
+	if err != nil {
+		d.Set("status", "FAILED")
+		return diag.FromErr(err)
+	}
+
+	d.Set("status", "COMPLETED")
+	d.Set("snapshot_tag", tag)
+
+	return nil
+}
+
+func resourceClusterUpgradeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// upgradeCluster drives the rolling upgrade of clusterID to targetVersion,
+// reporting intermediate progress via the resource's "status" attribute,
+// and returns the tag its snapshots were taken under. Those snapshots are
+// deleted before it returns successfully.
+func upgradeCluster(ctx context.Context, d *schema.ResourceData, c *scylla.Client, clusterID int64, targetVersion string) (string, error) {
+	cluster, err := c.GetCluster(clusterID)
+	if err != nil {
+		return "", fmt.Errorf("error reading cluster: %w", err)
+	}
+
+	mv := c.Meta.VersionByName(targetVersion)
+	if mv == nil {
+		return "", fmt.Errorf(`unrecognized value %q for "scylla_version" attribute`, targetVersion)
+	}
+
+	d.Set("status", "VERIFYING_SCHEMA_AGREEMENT")
+
+	agreed, err := c.SchemaAgreement(clusterID)
+	if err != nil {
+		return "", fmt.Errorf("error checking schema agreement: %w", err)
+	}
+	if !agreed {
+		return "", fmt.Errorf("cluster %d nodes are not in schema agreement, refusing to upgrade", clusterID)
+	}
+
+	patch := isPatchUpgrade(cluster.ScyllaVersion.Version, mv.Version)
+	tag := fmt.Sprintf("upgrade-%d-%s", clusterID, mv.Version)
+
+	d.Set("status", "SNAPSHOTTING_SYSTEM_KEYSPACES")
+
+	if err := c.CreateSnapshot(clusterID, tag, []string{"system", "system_schema"}); err != nil {
+		return "", fmt.Errorf("error snapshotting system keyspaces: %w", err)
+	}
+
+	nodes, err := c.ListNodes(clusterID)
+	if err != nil {
+		return "", fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	for _, n := range nodes {
+		if err := upgradeNode(ctx, d, c, n.ID, mv.VersionID, tag, patch); err != nil {
+			return "", fmt.Errorf("error upgrading node %d: %w", n.ID, err)
+		}
+	}
+
+	d.Set("status", "CLEANING_UP")
+
+	if err := c.DeleteSnapshot(clusterID, tag); err != nil {
+		return "", fmt.Errorf("error deleting system snapshot: %w", err)
+	}
+
+	return tag, nil
+}
+
+// upgradeNode drains, optionally snapshots, upgrades and waits for a
+// single node to come back up on the new version. Patch upgrades skip the
+// per-node data snapshot, since they carry no on-disk format change. Each
+// node gets its own bounded slice of the overall upgrade timeout, so one
+// stuck node fails fast instead of starving the rest of the rollout.
+func upgradeNode(ctx context.Context, d *schema.ResourceData, c *scylla.Client, nodeID, versionID int64, tag string, patch bool) error {
+	d.Set("status", fmt.Sprintf("DRAINING_NODE_%d", nodeID))
+
+	if err := c.DrainNode(nodeID); err != nil {
+		return fmt.Errorf("error draining node: %w", err)
+	}
+
+	if !patch {
+		d.Set("status", fmt.Sprintf("SNAPSHOTTING_NODE_%d", nodeID))
+
+		if err := c.CreateNodeSnapshot(nodeID, tag); err != nil {
+			return fmt.Errorf("error snapshotting node data: %w", err)
+		}
+	}
+
+	d.Set("status", fmt.Sprintf("UPGRADING_NODE_%d", nodeID))
+
+	r, err := c.UpgradeNode(nodeID, versionID)
+	if err != nil {
+		return fmt.Errorf("error requesting node upgrade: %w", err)
+	}
+
+	nodeCtx, cancel := context.WithTimeout(ctx, clusterUpgradeNodeTimeout)
+	defer cancel()
+
+	if err := waitForCluster(nodeCtx, c, r.ID); err != nil {
+		return fmt.Errorf("error waiting for node upgrade: %w", err)
+	}
+
+	// The cluster_request reaching COMPLETED only means the upgrade
+	// workflow finished, not that the node itself is confirmed running
+	// the new version; wait for that explicitly so the data snapshot
+	// below is never cleared ahead of a node that hasn't actually come
+	// back up on the target image.
+	if err := waitForNodeVersion(nodeCtx, c, nodeID, versionID); err != nil {
+		return fmt.Errorf("error waiting for node to report upgraded version: %w", err)
+	}
+
+	if !patch {
+		d.Set("status", fmt.Sprintf("CLEARING_SNAPSHOT_NODE_%d", nodeID))
+
+		if err := c.DeleteNodeSnapshot(nodeID, tag); err != nil {
+			return fmt.Errorf("error deleting node data snapshot: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// waitForNodeVersion polls nodeID until it reports versionID and an UP
+// status, backing off the same way waitForCluster does.
+func waitForNodeVersion(ctx context.Context, c *scylla.Client, nodeID, versionID int64) error {
+	timeout := clusterUpgradeNodeTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"UP"},
+		Delay:      clusterRetryDelay,
+		MinTimeout: clusterRetryMinTimeout,
+		Timeout:    timeout,
+		Refresh: func() (interface{}, string, error) {
+			n, err := c.GetNode(nodeID)
+			if err != nil {
+				return nil, "", fmt.Errorf("error reading node: %w", err)
+			}
+
+			if n.VersionID != versionID || strings.ToUpper(n.Status) != "UP" {
+				return n, "PENDING", nil
+			}
+
+			return n, "UP", nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// isPatchUpgrade reports whether target only bumps the patch component of
+// from, e.g. 5.2.3 -> 5.2.4, which requires no data snapshot. Both from and
+// target must be resolved, dotted version strings (model.Version.Version),
+// not the "scylla_version" attribute's raw input, which the API also
+// accepts as a name/alias that SplitN would otherwise misparse.
+func isPatchUpgrade(from, target string) bool {
+	f := strings.SplitN(from, ".", 3)
+	t := strings.SplitN(target, ".", 3)
+
+	if len(f) < 2 || len(t) < 2 {
+		return false
+	}
+
+	return f[0] == t[0] && f[1] == t[1]
+}
@@ -0,0 +1,25 @@
+package provider
+
+import "testing"
+
+func TestIsPatchUpgrade(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		target string
+		want   bool
+	}{
+		{name: "patch bump", from: "5.2.3", target: "5.2.4", want: true},
+		{name: "minor bump", from: "5.2.3", target: "5.4.0", want: false},
+		{name: "major bump", from: "5.2.3", target: "6.0.0", want: false},
+		{name: "unresolved alias", from: "5.2.3", target: "latest", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPatchUpgrade(tt.from, tt.target); got != tt.want {
+				t.Fatalf("isPatchUpgrade(%q, %q) = %v, want %v", tt.from, tt.target, got, tt.want)
+			}
+		})
+	}
+}